@@ -0,0 +1,20 @@
+package jsonparser
+
+// GetAny returns the value for the first of the given candidate key paths
+// that resolves, along with the index of the matching candidate. It fits the
+// log-level discovery pattern where a producer may emit `level`, `LEVEL`,
+// `severity`, or `lvl` for the same field: callers list their aliases in
+// priority order, and GetAny tries each in turn against data, short-circuiting
+// on the first one present — candidates are prioritized by their position in
+// the call, not by where they happen to fall in the document.
+func GetAny(data []byte, candidates ...[]string) (value []byte, vt ValueType, matchedPathIndex int, err error) {
+	for idx, path := range candidates {
+		v, t, _, gErr := Get(data, path...)
+		if gErr == KeyPathNotFoundError {
+			continue
+		}
+		return v, t, idx, gErr
+	}
+
+	return nil, NotExist, -1, KeyPathNotFoundError
+}
@@ -0,0 +1,82 @@
+package jsonparser
+
+import "testing"
+
+func TestGetIgnoreCaseMatchesDifferentCasing(t *testing.T) {
+	data := []byte(`{"Level":"warn"}`)
+
+	v, _, _, err := GetIgnoreCase(data, "level")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(v) != "warn" {
+		t.Fatalf("got %q want warn", v)
+	}
+
+	v, _, _, err = GetIgnoreCase(data, "LEVEL")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(v) != "warn" {
+		t.Fatalf("got %q want warn", v)
+	}
+}
+
+func TestGetIgnoreCaseStillRespectsPathNesting(t *testing.T) {
+	data := []byte(`{"a":{"LEVEL":"warn"},"LEVEL":"info"}`)
+
+	v, _, _, err := GetIgnoreCase(data, "level")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(v) != "info" {
+		t.Fatalf("got %q want info (top-level match, not nested)", v)
+	}
+}
+
+func TestGetAnyReturnsFirstMatchingCandidate(t *testing.T) {
+	data := []byte(`{"severity":"warn","lvl":"info"}`)
+
+	v, vt, idx, err := GetAny(data, []string{"level"}, []string{"severity"}, []string{"lvl"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if idx != 1 {
+		t.Fatalf("got matched index %d want 1", idx)
+	}
+	if vt != String || string(v) != "warn" {
+		t.Fatalf("got (%v, %q) want (String, warn)", vt, v)
+	}
+}
+
+func TestGetAnyPrefersCandidateOrderOverDocumentOrder(t *testing.T) {
+	// "lvl" comes first in the document, but "severity" is the caller's
+	// higher-priority candidate and should win regardless.
+	data := []byte(`{"lvl":"info","severity":"warn"}`)
+
+	v, _, idx, err := GetAny(data, []string{"severity"}, []string{"lvl"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if idx != 0 {
+		t.Fatalf("got matched index %d want 0 (severity, the higher-priority candidate)", idx)
+	}
+	if string(v) != "warn" {
+		t.Fatalf("got %q want warn", v)
+	}
+}
+
+func TestGetAnyNotFound(t *testing.T) {
+	data := []byte(`{"a":1}`)
+
+	_, vt, idx, err := GetAny(data, []string{"level"}, []string{"severity"})
+	if err != KeyPathNotFoundError {
+		t.Fatalf("got err=%v want KeyPathNotFoundError", err)
+	}
+	if idx != -1 {
+		t.Fatalf("got matched index %d want -1", idx)
+	}
+	if vt != NotExist {
+		t.Fatalf("got dataType %v want NotExist", vt)
+	}
+}
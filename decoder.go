@@ -0,0 +1,209 @@
+package jsonparser
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+)
+
+// minRead is the chunk size requested from the underlying reader each time
+// the Decoder's buffer needs to grow.
+const minRead = 4096
+
+// Delim is a JSON array or object delimiter, such as '[', ']', '{', or '}',
+// returned from Token.
+type Delim rune
+
+func (d Delim) String() string {
+	return string(rune(d))
+}
+
+// Decoder reads and decodes JSON values from an io.Reader, one buffered chunk
+// at a time, while preserving the path-based access that Get and ArrayEach
+// provide over a []byte. Each record is copied out of the internal buffer
+// before being handed to Get/ArrayEach, so the values they return remain
+// valid across later calls rather than being invalidated by the Decoder's
+// internal buffer compaction. It is meant for large NDJSON/log streams where
+// loading the whole payload up front isn't an option.
+type Decoder struct {
+	r   io.Reader
+	buf []byte
+	off int // start of the unconsumed portion of buf
+	err error
+}
+
+// NewDecoder returns a new Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// needsMore reports whether err indicates that the buffered data simply ended
+// before a value could be resolved, as opposed to a genuine syntax error. On
+// any of these errors the Decoder grows its buffer and retries from the same
+// offset rather than giving up.
+func needsMore(err error) bool {
+	switch err {
+	case KeyPathNotFoundError, MalformedStringError, MalformedArrayError,
+		MalformedObjectError, MalformedNumberError, MalformedLiteralError,
+		UnknownValueTypeError:
+		return true
+	}
+	return false
+}
+
+// fill compacts the already-consumed prefix out of buf and reads another
+// chunk from the underlying reader, growing buf if it's full.
+func (d *Decoder) fill() error {
+	if d.err != nil {
+		return d.err
+	}
+
+	if d.off > 0 {
+		n := copy(d.buf, d.buf[d.off:])
+		d.buf = d.buf[:n]
+		d.off = 0
+	}
+
+	if len(d.buf) == cap(d.buf) {
+		grown := make([]byte, len(d.buf), 2*cap(d.buf)+minRead)
+		copy(grown, d.buf)
+		d.buf = grown
+	}
+
+	n, err := d.r.Read(d.buf[len(d.buf):cap(d.buf)])
+	d.buf = d.buf[:len(d.buf)+n]
+	if err != nil {
+		d.err = err
+		// io.Reader permits returning data alongside io.EOF (or any other
+		// error) in the same call. When that happens, the bytes we just
+		// appended haven't been parsed yet, so don't report the error until
+		// a later fill finds nothing left to add.
+		if n == 0 {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextRecord resolves the next whole top-level JSON value in the stream,
+// filling the buffer as needed, without consuming it. The returned value is
+// fully bounded (its closing bracket/quote has already been seen), so a
+// package-level Get/ArrayEach over it never needs more data itself.
+func (d *Decoder) nextRecord() (record []byte, recordEnd int, err error) {
+	for {
+		skip := nextToken(d.buf[d.off:])
+		if skip == -1 {
+			if fillErr := d.fill(); fillErr != nil {
+				return nil, -1, fillErr
+			}
+			continue
+		}
+
+		record, _, offset, err := GetValue(d.buf[d.off+skip:])
+		if err != nil {
+			if needsMore(err) {
+				if fillErr := d.fill(); fillErr != nil {
+					return nil, -1, err
+				}
+				continue
+			}
+			return nil, -1, err
+		}
+
+		// record aliases d.buf, which fill() compacts and overwrites in place
+		// on every later call. Copy it out so that values Get/ArrayEach hand
+		// back to the caller stay valid across subsequent Decoder calls.
+		recordCopy := make([]byte, len(record))
+		copy(recordCopy, record)
+
+		return recordCopy, skip + offset, nil
+	}
+}
+
+// Get reads the next whole top-level value off the stream and resolves keys
+// within it, the way a call to Get would against one line of NDJSON. Each
+// call advances the Decoder past that value, so repeated calls walk forward
+// through the stream one record at a time.
+func (d *Decoder) Get(keys ...string) (value []byte, dataType ValueType, offset int, err error) {
+	record, recordEnd, err := d.nextRecord()
+	if err != nil {
+		return nil, NotExist, -1, err
+	}
+
+	d.off += recordEnd
+	return Get(record, keys...)
+}
+
+// ArrayEach resolves the array at keys within the next whole top-level value
+// on the stream and iterates it, advancing the Decoder past that value so the
+// next call moves on to the following record.
+func (d *Decoder) ArrayEach(cb func(value []byte, dataType ValueType, offset int, err error), keys ...string) error {
+	record, recordEnd, err := d.nextRecord()
+	if err != nil {
+		return err
+	}
+
+	d.off += recordEnd
+	return ArrayEach(record, cb, keys...)
+}
+
+// Token returns the next JSON token in the stream and advances past it,
+// mirroring encoding/json.Decoder.Token: object/array delimiters come back as
+// a Delim, and scalar values come back as a bool, float64, string, or nil.
+func (d *Decoder) Token() (interface{}, error) {
+	for {
+		skip := nextToken(d.buf[d.off:])
+		if skip == -1 {
+			if err := d.fill(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		d.off += skip
+		break
+	}
+
+	switch c := d.buf[d.off]; c {
+	case '{', '}', '[', ']':
+		d.off++
+		return Delim(c), nil
+	case ',', ':':
+		d.off++
+		return d.Token()
+	default:
+		for {
+			value, dataType, offset, err := GetValue(d.buf[d.off:])
+			if err != nil {
+				if needsMore(err) {
+					if fillErr := d.fill(); fillErr != nil {
+						return nil, err
+					}
+					continue
+				}
+				return nil, err
+			}
+
+			d.off += offset
+
+			switch dataType {
+			case String:
+				return string(value), nil
+			case Number:
+				return strconv.ParseFloat(unsafeBytesToString(value), 64)
+			case Boolean:
+				return value[0] == 't', nil
+			case Null:
+				return nil, nil
+			default:
+				return nil, UnknownValueTypeError
+			}
+		}
+	}
+}
+
+// Buffered returns a reader for the portion of the underlying stream that has
+// already been read into the Decoder but not yet consumed, so callers can
+// hand the remainder off to another protocol.
+func (d *Decoder) Buffered() io.Reader {
+	return bytes.NewReader(d.buf[d.off:])
+}
@@ -0,0 +1,110 @@
+package jsonparser
+
+// EachKey walks data once, invoking cb with the index of each path in paths
+// as soon as its value is found, rather than re-scanning data from the top
+// for every call to Get (which is O(N*K) when callers pull many fields out
+// of one document, e.g. extracting level/timestamp/msg/trace_id in one pass).
+// It returns the number of paths that were found.
+func EachKey(data []byte, cb func(idx int, value []byte, vt ValueType, err error), paths ...[]string) int {
+	maxDepth := 0
+	for _, p := range paths {
+		if len(p) > maxDepth {
+			maxDepth = len(p)
+		}
+	}
+
+	// keyStack[lvl-1] is the key name last seen at nesting level lvl, i.e.
+	// the live path from the root down to wherever the scanner currently is.
+	// Matching a path against this stack (rather than a monotonically
+	// increasing per-path counter) is what keeps sibling branches at the
+	// same depth from being confused with each other: a path only matches
+	// if the stack's prefix equals the path's prefix at every level, and the
+	// stack is overwritten as soon as the scanner moves to a new sibling.
+	keyStack := make([]string, maxDepth)
+	matched := make([]bool, len(paths))
+	found := 0
+
+	level := 0
+	i := 0
+	ln := len(data)
+
+	pathMatchesStack := func(path []string) bool {
+		for idx := 0; idx < level-1; idx++ {
+			if keyStack[idx] != path[idx] {
+				return false
+			}
+		}
+		return true
+	}
+
+	for i < ln {
+		switch data[i] {
+		case '"':
+			i++
+			keyBegin := i
+
+			strEnd := stringEnd(data[i:])
+			if strEnd == -1 {
+				return found
+			}
+			i += strEnd
+			keyEnd := i - 1
+
+			valueOffset := nextToken(data[i:])
+			if valueOffset == -1 {
+				return found
+			}
+			i += valueOffset
+
+			if data[i] == ':' {
+				key := unsafeBytesToString(data[keyBegin:keyEnd])
+
+				if level-1 >= 0 && level-1 < len(keyStack) {
+					keyStack[level-1] = key
+				}
+
+				for p, path := range paths {
+					if matched[p] || level-1 < 0 || len(path) != level {
+						continue
+					}
+					if path[level-1] != key || !pathMatchesStack(path) {
+						continue
+					}
+
+					matched[p] = true
+					found++
+
+					valueStart := i + 1
+					if skip := nextToken(data[valueStart:]); skip == -1 {
+						return found
+					} else {
+						valueStart += skip
+					}
+
+					value, vt, _, err := GetValue(data[valueStart:])
+					cb(p, value, vt, err)
+				}
+			} else {
+				i--
+			}
+		case '{':
+			level++
+		case '}':
+			level--
+		case '[':
+			arraySkip := blockEnd(data[i:], '[', ']')
+			if arraySkip == -1 {
+				return found
+			}
+			i += arraySkip - 1
+		}
+
+		if found == len(paths) {
+			return found
+		}
+
+		i++
+	}
+
+	return found
+}
@@ -0,0 +1,27 @@
+package jsonparser
+
+import "testing"
+
+func TestEachKeySiblingBranchesNotConfused(t *testing.T) {
+	data := []byte(`{"a":{"x":1},"a2":{"b":2}}`)
+
+	found := EachKey(data, func(idx int, value []byte, vt ValueType, err error) {
+		t.Fatalf("unexpected match for path %d: %s", idx, value)
+	}, []string{"a", "b"})
+
+	if found != 0 {
+		t.Fatalf("expected no matches, found %d", found)
+	}
+}
+
+func TestEachKeyMultiPath(t *testing.T) {
+	data := []byte(`{"level":"info","ts":123,"nested":{"level":"warn"},"msg":"hi"}`)
+	results := map[int]string{}
+	n := EachKey(data, func(idx int, value []byte, vt ValueType, err error) {
+		results[idx] = string(value)
+	}, []string{"level"}, []string{"msg"}, []string{"nested", "level"})
+
+	if n != 3 || results[0] != "info" || results[1] != "hi" || results[2] != "warn" {
+		t.Fatalf("unexpected results: n=%d %v", n, results)
+	}
+}
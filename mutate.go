@@ -0,0 +1,189 @@
+package jsonparser
+
+import "bytes"
+
+// splice returns a new slice with data[start:end] replaced by replacement.
+func splice(data []byte, start, end int, replacement []byte) []byte {
+	result := make([]byte, 0, len(data)-(end-start)+len(replacement))
+	result = append(result, data[:start]...)
+	result = append(result, replacement...)
+	result = append(result, data[end:]...)
+	return result
+}
+
+// buildKeyChain renders `"keys[0]":{"keys[1]":...value...}` for the given
+// path, wrapping value in as many nested objects as there are keys beyond
+// the first.
+func buildKeyChain(keys []string, value []byte) []byte {
+	var buf bytes.Buffer
+
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte('{')
+		}
+		buf.WriteByte('"')
+		buf.WriteString(key)
+		buf.WriteString(`":`)
+	}
+
+	buf.Write(value)
+
+	for i := 1; i < len(keys); i++ {
+		buf.WriteByte('}')
+	}
+
+	return buf.Bytes()
+}
+
+// Set changes the value for the given key path, creating any missing
+// intermediate objects along the way (e.g. setting ["a", "b"] on `{}` yields
+// `{"a":{"b":value}}`). It returns the resulting buffer, which may have been
+// reallocated.
+func Set(data []byte, value []byte, keys ...string) ([]byte, error) {
+	if len(keys) == 0 {
+		return append([]byte{}, value...), nil
+	}
+
+	if existing, existingType, offset, err := Get(data, keys...); err == nil {
+		start := offset - len(existing)
+		switch existingType {
+		case String:
+			start -= 2 // Get returns a string's contents without its quotes
+		case Null:
+			start -= len(nullLiteral) // Get returns a nil value for null
+		}
+		return splice(data, start, offset, value), nil
+	} else if err != KeyPathNotFoundError {
+		return nil, err
+	}
+
+	// No value exists at the full path yet. Walk back up the path looking
+	// for the deepest object that does exist, and create the rest of the
+	// chain inside it.
+	for i := len(keys) - 1; i >= 0; i-- {
+		objValue, dataType, offset, err := Get(data, keys[:i]...)
+		if err == KeyPathNotFoundError {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if dataType != Object {
+			return nil, KeyPathNotFoundError
+		}
+
+		start := offset - len(objValue)
+		insertPos := start + len(objValue) - 1 // position of the closing '}'
+
+		addition := buildKeyChain(keys[i:], value)
+		if len(bytes.TrimSpace(objValue[1:len(objValue)-1])) > 0 {
+			addition = append([]byte{','}, addition...)
+		}
+
+		return splice(data, insertPos, insertPos, addition), nil
+	}
+
+	return nil, KeyPathNotFoundError
+}
+
+// Delete removes the object member at the given key path, along with its key
+// and the surrounding comma, and returns the resulting buffer. Like Get, it
+// only addresses object members: there's no keys syntax for an array
+// element, so a path through an array is simply not found. If the key path
+// doesn't exist, data is returned unchanged.
+func Delete(data []byte, keys ...string) []byte {
+	if len(keys) == 0 {
+		return data
+	}
+
+	value, dataType, offset, err := Get(data, keys...)
+	if err != nil {
+		return data
+	}
+
+	start := offset - len(value)
+	switch dataType {
+	case String:
+		start -= 2 // Get returns a string's contents without its quotes
+	case Null:
+		start -= len(nullLiteral) // Get returns a nil value for null
+	}
+
+	// Walk back past the value to its key. Delete only ever addresses object
+	// members (Get/searchKeys skip over arrays rather than descending into
+	// them, so there's no keys syntax that reaches an array element).
+	// searchKeys matches keys against the raw bytes in data, so the key text
+	// is exactly keys[len(keys)-1].
+	lastKey := keys[len(keys)-1]
+	keyStart := start
+	for keyStart > 0 && isSpace(data[keyStart-1]) {
+		keyStart--
+	}
+	if keyStart > 0 && data[keyStart-1] == ':' {
+		keyStart--
+		for keyStart > 0 && isSpace(data[keyStart-1]) {
+			keyStart--
+		}
+
+		quoted := len(lastKey) + 2 // opening and closing '"'
+		if keyStart-quoted >= 0 && data[keyStart-1] == '"' && data[keyStart-quoted] == '"' {
+			keyStart -= quoted
+		}
+	}
+
+	// Pull in the indentation (and line break) in front of the key too, so
+	// deleting a member from pretty-printed JSON doesn't leave a blank line
+	// behind.
+	for keyStart > 0 && isSpace(data[keyStart-1]) {
+		keyStart--
+	}
+
+	end := offset
+	if skip := nextToken(data[end:]); skip != -1 && data[end+skip] == ',' {
+		// A following comma belongs to us (first or middle member).
+		end += skip + 1
+	} else {
+		// No following comma: if there's a preceding one (middle or last
+		// member), it belongs to us instead.
+		j := keyStart
+		for j > 0 && isSpace(data[j-1]) {
+			j--
+		}
+		if j > 0 && data[j-1] == ',' {
+			keyStart = j - 1
+		}
+	}
+
+	return splice(data, keyStart, end, nil)
+}
+
+// Append adds value as a new element of the array at the given key path. The
+// array must already exist, empty or not. It returns the resulting buffer,
+// which may have been reallocated.
+func Append(data []byte, value []byte, keys ...string) ([]byte, error) {
+	arrValue, dataType, offset, err := Get(data, keys...)
+	if err != nil {
+		return nil, err
+	}
+	if dataType != Array {
+		return nil, ExpectedArrayError
+	}
+
+	start := offset - len(arrValue)
+	insertPos := start + len(arrValue) - 1 // position of the closing ']'
+
+	addition := make([]byte, 0, len(value)+1)
+	if len(bytes.TrimSpace(arrValue[1:len(arrValue)-1])) > 0 {
+		addition = append(addition, ',')
+	}
+	addition = append(addition, value...)
+
+	return splice(data, insertPos, insertPos, addition), nil
+}
+
+func isSpace(c byte) bool {
+	switch c {
+	case ' ', '\n', '\r', '\t':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package jsonparser
+
+import "testing"
+
+func TestDeleteFirstMember(t *testing.T) {
+	data := []byte(`{"a":1,"b":2,"c":3}`)
+	got := Delete(data, "a")
+	want := `{"b":2,"c":3}`
+	if string(got) != want {
+		t.Fatalf("got %s want %s", got, want)
+	}
+}
+
+func TestDeleteMiddleMember(t *testing.T) {
+	data := []byte(`{"a":1,"b":2,"c":3}`)
+	got := Delete(data, "b")
+	want := `{"a":1,"c":3}`
+	if string(got) != want {
+		t.Fatalf("got %s want %s", got, want)
+	}
+}
+
+func TestDeleteLastMember(t *testing.T) {
+	data := []byte(`{"a":1,"b":2,"c":3}`)
+	got := Delete(data, "c")
+	want := `{"a":1,"b":2}`
+	if string(got) != want {
+		t.Fatalf("got %s want %s", got, want)
+	}
+}
+
+func TestDeleteOnlyMember(t *testing.T) {
+	data := []byte(`{"a":1}`)
+	got := Delete(data, "a")
+	want := `{}`
+	if string(got) != want {
+		t.Fatalf("got %s want %s", got, want)
+	}
+}
+
+func TestDeletePrettyPrintedLeavesNoBlankLine(t *testing.T) {
+	data := []byte("{\n  \"a\": 1,\n  \"b\": 2,\n  \"c\": 3\n}")
+	got := Delete(data, "b")
+	want := "{\n  \"a\": 1,\n  \"c\": 3\n}"
+	if string(got) != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+}
+
+func TestDeletePrettyPrintedFirstMember(t *testing.T) {
+	data := []byte("{\n  \"a\": 1,\n  \"b\": 2\n}")
+	got := Delete(data, "a")
+	want := "{\n  \"b\": 2\n}"
+	if string(got) != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+}
+
+func TestDeletePrettyPrintedLastMember(t *testing.T) {
+	data := []byte("{\n  \"a\": 1,\n  \"b\": 2\n}")
+	got := Delete(data, "b")
+	want := "{\n  \"a\": 1\n}"
+	if string(got) != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+}
+
+func TestDeleteNotFoundLeavesDataUnchanged(t *testing.T) {
+	data := []byte(`{"a":1}`)
+	got := Delete(data, "missing")
+	if string(got) != string(data) {
+		t.Fatalf("got %s want unchanged %s", got, data)
+	}
+}
+
+func TestSetExistingKey(t *testing.T) {
+	data := []byte(`{"a":1,"b":2}`)
+	got, err := Set(data, []byte(`99`), "b")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"a":1,"b":99}`
+	if string(got) != want {
+		t.Fatalf("got %s want %s", got, want)
+	}
+}
+
+func TestSetCreatesNestedChainOnEmptyObject(t *testing.T) {
+	data := []byte(`{}`)
+	got, err := Set(data, []byte(`1`), "a", "b")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"a":{"b":1}}`
+	if string(got) != want {
+		t.Fatalf("got %s want %s", got, want)
+	}
+}
+
+func TestSetCreatesNestedChainUnderExistingObject(t *testing.T) {
+	data := []byte(`{"a":{"x":1}}`)
+	got, err := Set(data, []byte(`2`), "a", "y")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"a":{"x":1,"y":2}}`
+	if string(got) != want {
+		t.Fatalf("got %s want %s", got, want)
+	}
+}
+
+func TestSetFailsWhenIntermediateSegmentIsNotObject(t *testing.T) {
+	data := []byte(`{"a":1}`)
+	if _, err := Set(data, []byte(`2`), "a", "b"); err != KeyPathNotFoundError {
+		t.Fatalf("got err=%v want KeyPathNotFoundError", err)
+	}
+}
+
+func TestAppendToEmptyArray(t *testing.T) {
+	data := []byte(`{"a":[]}`)
+	got, err := Append(data, []byte(`1`), "a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"a":[1]}`
+	if string(got) != want {
+		t.Fatalf("got %s want %s", got, want)
+	}
+}
+
+func TestAppendToPopulatedArray(t *testing.T) {
+	data := []byte(`{"a":[1,2]}`)
+	got, err := Append(data, []byte(`3`), "a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"a":[1,2,3]}`
+	if string(got) != want {
+		t.Fatalf("got %s want %s", got, want)
+	}
+}
+
+func TestAppendFailsWhenPathIsNotArray(t *testing.T) {
+	data := []byte(`{"a":1}`)
+	if _, err := Append(data, []byte(`1`), "a"); err != ExpectedArrayError {
+		t.Fatalf("got err=%v want ExpectedArrayError", err)
+	}
+}
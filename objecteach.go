@@ -0,0 +1,86 @@
+package jsonparser
+
+import "errors"
+
+// ExpectedObjectError is returned by ObjectEach when the resolved value is
+// not a JSON object.
+var ExpectedObjectError = errors.New("Expected an object as input, but received something that is not an object")
+
+// ObjectEach is used when iterating object members, symmetric to ArrayEach:
+// it navigates to the object at keys, then walks its members, invoking cb
+// with each key, value, the value's type, and the offset where the value
+// ends. Returning an error from cb stops iteration and is returned from
+// ObjectEach.
+func ObjectEach(data []byte, cb func(key []byte, value []byte, vt ValueType, offset int) error, keys ...string) (err error) {
+	objValue, dataType, objOffset, err := Get(data, keys...)
+	if err != nil {
+		return err
+	} else if dataType != Object {
+		return ExpectedObjectError
+	}
+
+	objBeginOffset := objOffset - len(objValue) // overall offset of objValue within data
+	offsetInObj := 1                            // skip the '{' (guaranteed to exist because we know it's an Object type)
+
+	// Skip to the first member, if any.
+	if skip := nextToken(objValue[offsetInObj:]); skip == -1 {
+		return MalformedObjectError
+	} else {
+		offsetInObj += skip
+	}
+
+	endOffsetInObj := len(objValue) - 1
+	for offsetInObj < endOffsetInObj {
+		if objValue[offsetInObj] != '"' {
+			return MalformedObjectError
+		}
+		offsetInObj++
+		keyBegin := offsetInObj
+
+		strEnd := stringEnd(objValue[offsetInObj:])
+		if strEnd == -1 {
+			return MalformedStringError
+		}
+		offsetInObj += strEnd
+		key := objValue[keyBegin : offsetInObj-1]
+
+		if skip := nextToken(objValue[offsetInObj:]); skip == -1 {
+			return MalformedObjectError
+		} else {
+			offsetInObj += skip
+		}
+
+		if objValue[offsetInObj] != ':' {
+			return MalformedObjectError
+		}
+		offsetInObj++
+
+		if skip := nextToken(objValue[offsetInObj:]); skip == -1 {
+			return MalformedObjectError
+		} else {
+			offsetInObj += skip
+		}
+
+		value, valueType, valueOffset, err := GetValue(objValue[offsetInObj:])
+		offsetInObj += valueOffset // update offsetInObj before calling cb() so that it points to the end of the member value
+
+		if valueType == NotExist {
+			break
+		}
+
+		if cbErr := cb(key, value, valueType, objBeginOffset+offsetInObj); cbErr != nil {
+			return cbErr
+		} else if err != nil {
+			return err
+		}
+
+		// Skip to the next member, if any.
+		if skip := nextArrayElement(objValue[offsetInObj:]); skip == -1 {
+			break
+		} else {
+			offsetInObj += skip
+		}
+	}
+
+	return nil
+}
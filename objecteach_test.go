@@ -0,0 +1,82 @@
+package jsonparser
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestObjectEachMultiMember(t *testing.T) {
+	data := []byte(`{"a":1,"b":"two","c":true}`)
+	got := map[string]string{}
+	var order []string
+
+	err := ObjectEach(data, func(key []byte, value []byte, vt ValueType, offset int) error {
+		got[string(key)] = string(value)
+		order = append(order, string(key))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{"a": "1", "b": "two", "c": "true"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("got %v want %v", got, want)
+		}
+	}
+
+	wantOrder := []string{"a", "b", "c"}
+	for i, k := range wantOrder {
+		if order[i] != k {
+			t.Fatalf("got order %v want %v", order, wantOrder)
+		}
+	}
+}
+
+func TestObjectEachEmptyObject(t *testing.T) {
+	data := []byte(`{}`)
+	calls := 0
+
+	err := ObjectEach(data, func(key []byte, value []byte, vt ValueType, offset int) error {
+		calls++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("got %d callback calls, want 0", calls)
+	}
+}
+
+func TestObjectEachCallbackErrorStopsIteration(t *testing.T) {
+	data := []byte(`{"a":1,"b":2,"c":3}`)
+	errStop := errors.New("stop")
+	var seen []string
+
+	err := ObjectEach(data, func(key []byte, value []byte, vt ValueType, offset int) error {
+		seen = append(seen, string(key))
+		if string(key) == "b" {
+			return errStop
+		}
+		return nil
+	})
+
+	if err != errStop {
+		t.Fatalf("got err=%v want %v", err, errStop)
+	}
+	want := []string{"a", "b"}
+	if len(seen) != len(want) {
+		t.Fatalf("got %v want %v", seen, want)
+	}
+	for i := range want {
+		if seen[i] != want[i] {
+			t.Fatalf("got %v want %v", seen, want)
+		}
+	}
+}
+
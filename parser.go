@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"unsafe"
 )
 
@@ -21,6 +22,8 @@ var (
 	MalformedLiteralError = errors.New("Value looks like Boolean/null, but can't find its end: ',' or '}' symbol")
 
 	ExpectedArrayError = errors.New("Expected an array as input, but received something that is not an array")
+
+	InvalidJsonPointerError = errors.New("JSON pointer must be empty or start with '/'")
 )
 
 func tokenEnd(data []byte) int {
@@ -129,6 +132,12 @@ func blockEnd(data []byte, openSym byte, closeSym byte) int {
 }
 
 func searchKeys(data []byte, keys ...string) int {
+	return searchKeysCased(data, false, keys...)
+}
+
+// searchKeysCased is searchKeys with an optional ASCII case-insensitive key
+// comparison, used by GetIgnoreCase.
+func searchKeysCased(data []byte, ignoreCase bool, keys ...string) int {
 	keyLevel := 0
 	level := 0
 	i := 0
@@ -160,7 +169,7 @@ func searchKeys(data []byte, keys ...string) int {
 				key := unsafeBytesToString(data[keyBegin:keyEnd])
 
 				if keyLevel == level-1 && // If key nesting level match current object nested level
-					keys[level-1] == key {
+					keyEqual(keys[level-1], key, ignoreCase) {
 					keyLevel++
 					// If we found all keys in path
 					if keyLevel == lk {
@@ -177,6 +186,9 @@ func searchKeys(data []byte, keys ...string) int {
 		case '[':
 			// Do not search for keys inside arrays
 			arraySkip := blockEnd(data[i:], '[', ']')
+			if arraySkip == -1 {
+				return -1
+			}
 			i += arraySkip - 1
 		}
 
@@ -186,6 +198,15 @@ func searchKeys(data []byte, keys ...string) int {
 	return -1
 }
 
+// keyEqual compares a path segment against a key found in data, optionally
+// ignoring ASCII case (for GetIgnoreCase).
+func keyEqual(pathKey, dataKey string, ignoreCase bool) bool {
+	if !ignoreCase {
+		return pathKey == dataKey
+	}
+	return strings.EqualFold(pathKey, dataKey)
+}
+
 // Data types available in valid JSON data.
 type ValueType int
 
@@ -213,8 +234,19 @@ Accept multiple keys to specify path to JSON value (in case of quering nested st
 If no keys provided it will try to extract closest JSON value (simple ones or object/array), useful for reading streams or arrays, see `ArrayEach` implementation.
 */
 func Get(data []byte, keys ...string) (value []byte, dataType ValueType, offset int, err error) {
+	return getCased(data, false, keys...)
+}
+
+// GetIgnoreCase is Get with ASCII case-insensitive key matching, useful when
+// a producer's casing for a field name isn't guaranteed (e.g. `level` vs.
+// `Level` vs. `LEVEL`).
+func GetIgnoreCase(data []byte, keys ...string) (value []byte, dataType ValueType, offset int, err error) {
+	return getCased(data, true, keys...)
+}
+
+func getCased(data []byte, ignoreCase bool, keys ...string) (value []byte, dataType ValueType, offset int, err error) {
 	if len(keys) > 0 {
-		if offset = searchKeys(data, keys...); offset == -1 {
+		if offset = searchKeysCased(data, ignoreCase, keys...); offset == -1 {
 			return nil, NotExist, -1, KeyPathNotFoundError
 		}
 	}
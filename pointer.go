@@ -0,0 +1,131 @@
+package jsonparser
+
+import (
+	"strconv"
+	"strings"
+)
+
+// arrayIndex returns the absolute offset within data (an array value,
+// brackets included) where its n'th element begins, by walking elements via
+// nextArrayElement the same way ArrayEach does. It returns -1 if data isn't
+// an array or has fewer than n+1 elements.
+func arrayIndex(data []byte, n int) (offset int) {
+	if n < 0 || len(data) == 0 || data[0] != '[' {
+		return -1
+	}
+
+	offsetInArray := 1
+	if skip := nextToken(data[offsetInArray:]); skip == -1 {
+		return -1
+	} else {
+		offsetInArray += skip
+	}
+
+	endOffsetInArray := len(data) - 1
+	for idx := 0; offsetInArray < endOffsetInArray; idx++ {
+		if idx == n {
+			return offsetInArray
+		}
+
+		_, valueType, valueOffset, err := GetValue(data[offsetInArray:])
+		if err != nil || valueType == NotExist {
+			return -1
+		}
+		offsetInArray += valueOffset
+
+		if skip := nextArrayElement(data[offsetInArray:]); skip == -1 {
+			return -1
+		} else {
+			offsetInArray += skip
+		}
+	}
+
+	return -1
+}
+
+// isArrayIndex reports whether tok is a valid RFC 6901 array-index token:
+// either "0" or a non-zero digit followed by any number of digits. A
+// leading-zero token like "01" doesn't qualify and is looked up as an
+// object-style key instead.
+func isArrayIndex(tok string) bool {
+	if tok == "" {
+		return false
+	}
+	if tok[0] == '0' {
+		return tok == "0"
+	}
+	for i := 0; i < len(tok); i++ {
+		if tok[i] < '0' || tok[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// unescapePointerToken reverses the RFC 6901 escaping of a pointer segment:
+// "~1" becomes "/" and "~0" becomes "~" (in that order, so that "~01", an
+// escaped "~" followed by "1", decodes to "~1" rather than "/").
+func unescapePointerToken(tok string) string {
+	if !strings.Contains(tok, "~") {
+		return tok
+	}
+	tok = strings.ReplaceAll(tok, "~1", "/")
+	tok = strings.ReplaceAll(tok, "~0", "~")
+	return tok
+}
+
+// GetPointer resolves the value at the RFC 6901 JSON Pointer ptr (e.g.
+// "/foo/0/bar") within data, dispatching each token to the existing
+// searchKeys/array-index machinery rather than requiring callers to hand-split
+// paths. An empty ptr returns the whole document; "/" addresses the
+// empty-string key at the root. This gives interop with JSON Patch, JSON
+// Schema `$ref`, and HTTP problem+json, and is a natural companion to Set and
+// Delete, which could grow the same syntax.
+func GetPointer(data []byte, ptr string) (value []byte, dataType ValueType, offset int, err error) {
+	if ptr == "" {
+		return Get(data)
+	}
+	if ptr[0] != '/' {
+		return nil, NotExist, -1, InvalidJsonPointerError
+	}
+
+	_, rootType, _, err := GetValue(data)
+	if err != nil {
+		return nil, Unknown, -1, err
+	}
+
+	value = data
+	dataType = rootType
+	base := 0 // absolute offset of value's start within data
+
+	for _, raw := range strings.Split(ptr[1:], "/") {
+		tok := unescapePointerToken(raw)
+
+		if dataType == Array && isArrayIndex(tok) {
+			idx, _ := strconv.Atoi(tok)
+			elemStart := arrayIndex(value, idx)
+			if elemStart == -1 {
+				return nil, NotExist, -1, KeyPathNotFoundError
+			}
+
+			v, vt, vOffset, vErr := GetValue(value[elemStart:])
+			if vErr != nil {
+				return nil, Unknown, -1, vErr
+			}
+
+			base += elemStart + vOffset - len(v)
+			value, dataType = v, vt
+			continue
+		}
+
+		v, vt, vOffset, vErr := Get(value, tok)
+		if vErr != nil {
+			return nil, NotExist, -1, vErr
+		}
+
+		base += vOffset - len(v)
+		value, dataType = v, vt
+	}
+
+	return value, dataType, base + len(value), nil
+}
@@ -0,0 +1,60 @@
+package jsonparser
+
+import "testing"
+
+func TestGetPointerArrayRoot(t *testing.T) {
+	v, vt, _, err := GetPointer([]byte(`[10,20,30]`), "/1")
+	if err != nil || vt != Number || string(v) != "20" {
+		t.Fatalf("array root: %s %v %v", v, vt, err)
+	}
+}
+
+func TestGetPointerObjectRootStillWorks(t *testing.T) {
+	v, _, _, err := GetPointer([]byte(`{"foo":[{"bar":1},{"bar":2}]}`), "/foo/1/bar")
+	if err != nil || string(v) != "2" {
+		t.Fatalf("object root: %s %v", v, err)
+	}
+}
+
+func TestGetPointerRejectsLeadingZeroAsArrayIndex(t *testing.T) {
+	_, _, _, err := GetPointer([]byte(`[10,20,30]`), "/01")
+	if err != KeyPathNotFoundError {
+		t.Fatalf("got err=%v want KeyPathNotFoundError (\"01\" is not a valid array index)", err)
+	}
+}
+
+func TestGetPointerZeroIsStillAValidArrayIndex(t *testing.T) {
+	v, vt, _, err := GetPointer([]byte(`[10,20,30]`), "/0")
+	if err != nil || vt != Number || string(v) != "10" {
+		t.Fatalf("got %s %v %v want 10 Number nil", v, vt, err)
+	}
+}
+
+func TestGetPointerUnescapesTokens(t *testing.T) {
+	data := []byte(`{"a/b":1,"c~d":2}`)
+
+	v, _, _, err := GetPointer(data, "/a~1b")
+	if err != nil || string(v) != "1" {
+		t.Fatalf("~1: got %s %v want 1 nil", v, err)
+	}
+
+	v, _, _, err = GetPointer(data, "/c~0d")
+	if err != nil || string(v) != "2" {
+		t.Fatalf("~0: got %s %v want 2 nil", v, err)
+	}
+}
+
+func TestGetPointerEmptyReturnsWholeDocument(t *testing.T) {
+	data := []byte(`{"a":1}`)
+	v, vt, _, err := GetPointer(data, "")
+	if err != nil || vt != Object || string(v) != string(data) {
+		t.Fatalf("got %s %v %v want whole document", v, vt, err)
+	}
+}
+
+func TestGetPointerInvalidWithoutLeadingSlash(t *testing.T) {
+	_, _, _, err := GetPointer([]byte(`{"a":1}`), "a")
+	if err != InvalidJsonPointerError {
+		t.Fatalf("got err=%v want InvalidJsonPointerError", err)
+	}
+}